@@ -0,0 +1,67 @@
+// Command ci is the build/test driver for Anya-core, modeled on
+// go-ethereum's build/ci.go. Only the subcommands this repo currently
+// needs are implemented; add more as the build grows.
+//
+// Usage: go run build/ci.go <command> [options]
+//
+//	go run build/ci.go aar       -- build the Android AAR for mobile/pkg/bitcoin
+//	go run build/ci.go xcframework -- build the iOS XCFramework for mobile/pkg/bitcoin
+package main
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+)
+
+const mobilePkg = "github.com/Anya-org/Anya-core/mobile/pkg/bitcoin"
+
+func main() {
+	if len(os.Args) < 2 {
+		fmt.Fprintln(os.Stderr, "usage: go run build/ci.go <command>")
+		os.Exit(2)
+	}
+
+	var err error
+	switch os.Args[1] {
+	case "aar":
+		err = doAAR()
+	case "xcframework":
+		err = doXCFramework()
+	default:
+		fmt.Fprintf(os.Stderr, "unknown command %q\n", os.Args[1])
+		os.Exit(2)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
+
+// doAAR produces build/bin/anya-bitcoin.aar via gomobile bind, targeting
+// android/arm64 and android/amd64.
+func doAAR() error {
+	return run("gomobile", "bind",
+		"-target", "android/arm64,android/amd64",
+		"-o", "build/bin/anya-bitcoin.aar",
+		mobilePkg,
+	)
+}
+
+// doXCFramework produces build/bin/AnyaBitcoin.xcframework via gomobile
+// bind, targeting ios/arm64 and iossimulator/arm64.
+func doXCFramework() error {
+	return run("gomobile", "bind",
+		"-target", "ios/arm64,iossimulator/arm64",
+		"-o", "build/bin/AnyaBitcoin.xcframework",
+		mobilePkg,
+	)
+}
+
+func run(name string, args ...string) error {
+	cmd := exec.Command(name, args...)
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	fmt.Println("running", name, args)
+	return cmd.Run()
+}
@@ -0,0 +1,22 @@
+package mobile
+
+// DeriveAccount derives the account at the given BIP-32 derivation path
+// (e.g. "m/84'/0'/0'/0/0") and returns a gomobile-safe view of it. The
+// private key itself never leaves the Rust core.
+func (sdk *BitcoinMobileSDK) DeriveAccount(path string) (*Account, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if path == "" {
+		return nil, ErrInvalidPath
+	}
+	return sdk.backend.DeriveAccount(path)
+}
+
+// DefaultAccount derives the default receive account for the SDK's
+// network, using the standard BIP-84 (native segwit) external chain path.
+func (sdk *BitcoinMobileSDK) DefaultAccount() (*Account, error) {
+	coinType := paramsForNetwork(sdk.config.Network).CoinType
+	path := defaultAccountPath(coinType)
+	return sdk.DeriveAccount(path)
+}
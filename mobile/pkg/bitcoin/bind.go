@@ -0,0 +1,70 @@
+package mobile
+
+// coreBackend is the single FFI boundary between this package and the Rust
+// anya_bitcoin core. Every cgo call the SDK makes is funneled through an
+// implementation of this interface, so the rest of the package - and its
+// tests - never import "C" or touch cgo types directly.
+//
+// Platform build files (mobile_android.go, mobile_ios.go, mobile_other.go)
+// each provide a newCoreBackend that links against the anya_bitcoin static
+// or shared library appropriate for that target.
+type coreBackend interface {
+	// VerifySPVProof checks txHash's inclusion in the chain and returns
+	// the structured Merkle proof of it.
+	VerifySPVProof(txHash string) (*SPVProof, error)
+
+	// CreateLightningInvoice creates a BOLT11 invoice for amountSat
+	// satoshis and returns the encoded invoice string.
+	CreateLightningInvoice(amountSat int64) (string, error)
+
+	// DeriveAccount derives the account at the given BIP-32 path and
+	// returns its address and public key.
+	DeriveAccount(path string) (*Account, error)
+
+	// InitiatePayment starts sending req and returns an opaque attempt ID
+	// used to poll for status via PollPayment. It must return quickly;
+	// the payment itself resolves asynchronously in the core.
+	InitiatePayment(req PaymentRequest) (attemptID string, err error)
+
+	// PollPayment returns the latest known status of the payment attempt
+	// started by InitiatePayment or identified by TrackPayment.
+	PollPayment(attemptID string) (*PaymentUpdate, error)
+
+	// CancelPayment cancels an in-flight payment identified by its
+	// payment hash, if the core supports cancellation for it.
+	CancelPayment(paymentHash []byte) error
+
+	// ListPayments returns all payments known to the core, most recent
+	// first.
+	ListPayments() ([]Payment, error)
+
+	// DecodeInvoice decodes a BOLT11 invoice without paying it.
+	DecodeInvoice(invoice string) (*DecodedInvoice, error)
+
+	// BridgeEthAddress computes the EVM address tied to btcAddr. The core
+	// uses keccak256(decompress(pubkey))[12:] when it still holds the
+	// public key behind btcAddr, falling back to a lookup against
+	// registryAddr when only the address is known.
+	BridgeEthAddress(btcAddr string, registryAddr string) (ethAddr string, err error)
+
+	// DeriveSmartAccount computes the ERC-4337 smart contract account a
+	// kernel factory would deploy for ethAddr, and reports whether that
+	// account is already deployed on-chain.
+	DeriveSmartAccount(ethAddr string, registry string, factory string) (scaAddr string, deployed bool, err error)
+
+	// SignUserOperation signs an ERC-4337 UserOperation's EntryPoint hash
+	// using the key behind btcAddr.
+	SignUserOperation(btcAddr string, userOp UserOperation) (signature []byte, err error)
+
+	// FindPaymentToAddress reports the chain's current view of payments
+	// made to addr, which may be a raw address or a BIP21 URI; the core
+	// owns parsing and matching logic for both forms. It is used by
+	// PaymentChecker to discover the transaction, if any, that pays a
+	// registered on-chain watch.
+	FindPaymentToAddress(addr string) (*AddressPayment, error)
+}
+
+// newCoreBackend constructs the coreBackend for the current build target.
+// It is a package variable, not a plain function call, so unit tests can
+// swap in a fake backend without linking the native library.
+var newCoreBackend func() (coreBackend, error)
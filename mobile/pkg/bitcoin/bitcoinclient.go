@@ -0,0 +1,42 @@
+// Package mobile is the gomobile-facing SDK surface for Anya's Bitcoin
+// core. It is built into an Android AAR and an iOS XCFramework and must
+// only export types gomobile can bind: strings, byte slices, ints, bools,
+// and structs/interfaces built from those.
+package mobile
+
+// BitcoinMobileSDK is the root handle a mobile app holds. It is modeled on
+// ethclient.Client: a thin, stateless wrapper around the coreBackend FFI
+// boundary, with one file per logical area of functionality (accounts,
+// payments, SPV proofs, ...).
+type BitcoinMobileSDK struct {
+	config  Config
+	backend coreBackend
+}
+
+// NewBitcoinMobileSDK constructs an SDK bound to cfg's network, wiring up
+// the platform-appropriate coreBackend. cfg may be the zero value, in
+// which case the SDK defaults to Mainnet.
+func NewBitcoinMobileSDK(cfg *Config) (*BitcoinMobileSDK, error) {
+	if cfg == nil {
+		cfg = &Config{}
+	}
+	if newCoreBackend == nil {
+		return nil, ErrBackendUnavailable
+	}
+	backend, err := newCoreBackend()
+	if err != nil {
+		return nil, err
+	}
+	return &BitcoinMobileSDK{config: *cfg, backend: backend}, nil
+}
+
+// CreateInvoice creates a Lightning BOLT11 invoice for amount satoshis.
+func (sdk *BitcoinMobileSDK) CreateInvoice(amount int64) (string, error) {
+	if sdk.backend == nil {
+		return "", ErrNotInitialized
+	}
+	if amount <= 0 {
+		return "", ErrInvalidAmount
+	}
+	return sdk.backend.CreateLightningInvoice(amount)
+}
@@ -0,0 +1,73 @@
+package mobile
+
+// UserOperation is an ERC-4337 user operation as defined by the account
+// abstraction EntryPoint contract. Field order matches the canonical
+// struct so the Rust core can ABI-encode it directly for hashing.
+type UserOperation struct {
+	Sender               string
+	Nonce                uint64
+	InitCode             []byte
+	CallData             []byte
+	CallGasLimit         uint64
+	VerificationGasLimit uint64
+	PreVerificationGas   uint64
+	MaxFeePerGas         uint64
+	MaxPriorityFeePerGas uint64
+	PaymasterAndData     []byte
+}
+
+// BitcoinAddressToEthAddress deterministically computes the EVM address
+// tied to a Bitcoin key, inspired by B2's btc-address-to-eth-address
+// bridge: eth_addr = keccak256(decompress(btc_pubkey))[12:]. The core
+// derives it directly when it still holds the public key behind btcAddr
+// (for example because it was derived earlier via DeriveAccount),
+// otherwise it falls back to a lookup against Config.EVMRegistryAddress.
+func (sdk *BitcoinMobileSDK) BitcoinAddressToEthAddress(btcAddr string) (string, error) {
+	if sdk.backend == nil {
+		return "", ErrNotInitialized
+	}
+	if btcAddr == "" {
+		return "", ErrInvalidAddress
+	}
+	return sdk.backend.BridgeEthAddress(btcAddr, sdk.config.EVMRegistryAddress)
+}
+
+// DeriveSmartAccount computes the ERC-4337 smart contract account address
+// that factory would deploy for btcAddr's bridged EVM identity, and
+// reports whether that account has already been deployed on-chain. An
+// empty registry or factory falls back to the SDK's configured
+// Config.EVMRegistryAddress / Config.EVMFactoryAddress.
+func (sdk *BitcoinMobileSDK) DeriveSmartAccount(btcAddr string, registry, factory string) (string, bool, error) {
+	if sdk.backend == nil {
+		return "", false, ErrNotInitialized
+	}
+	if btcAddr == "" {
+		return "", false, ErrInvalidAddress
+	}
+	if registry == "" {
+		registry = sdk.config.EVMRegistryAddress
+	}
+	if factory == "" {
+		factory = sdk.config.EVMFactoryAddress
+	}
+
+	ethAddr, err := sdk.backend.BridgeEthAddress(btcAddr, registry)
+	if err != nil {
+		return "", false, err
+	}
+	return sdk.backend.DeriveSmartAccount(ethAddr, registry, factory)
+}
+
+// SignUserOperation signs userOp's EntryPoint hash using the same
+// Bitcoin-held key behind btcAddr, so a mobile app can build an ERC-4337
+// operation off-chain and submit it to a bundler as part of a cross-chain
+// account-abstraction flow.
+func (sdk *BitcoinMobileSDK) SignUserOperation(btcAddr string, userOp UserOperation) ([]byte, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if btcAddr == "" {
+		return nil, ErrInvalidAddress
+	}
+	return sdk.backend.SignUserOperation(btcAddr, userOp)
+}
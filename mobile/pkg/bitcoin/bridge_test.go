@@ -0,0 +1,101 @@
+package mobile
+
+import "testing"
+
+// bridgeBackend is a coreBackend stub recording the registry/factory
+// arguments DeriveSmartAccount passes through, for tests that don't need
+// the cgo core.
+type bridgeBackend struct {
+	fakeBackend
+
+	gotRegistry string
+	ethAddr     string
+	bridgeErr   error
+
+	gotFactory   string
+	scaAddr      string
+	deployed     bool
+	deriveSCAErr error
+}
+
+func (b *bridgeBackend) BridgeEthAddress(btcAddr, registry string) (string, error) {
+	b.gotRegistry = registry
+	return b.ethAddr, b.bridgeErr
+}
+
+func (b *bridgeBackend) DeriveSmartAccount(ethAddr, registry, factory string) (string, bool, error) {
+	b.gotFactory = factory
+	return b.scaAddr, b.deployed, b.deriveSCAErr
+}
+
+func TestDeriveSmartAccountDefaultsRegistryAndFactory(t *testing.T) {
+	backend := &bridgeBackend{ethAddr: "0xeth", scaAddr: "0xsca", deployed: true}
+	sdk := &BitcoinMobileSDK{
+		backend: backend,
+		config:  Config{EVMRegistryAddress: "0xregistry", EVMFactoryAddress: "0xfactory"},
+	}
+
+	scaAddr, deployed, err := sdk.DeriveSmartAccount("bc1q...", "", "")
+	if err != nil {
+		t.Fatalf("DeriveSmartAccount: %v", err)
+	}
+	if scaAddr != "0xsca" || !deployed {
+		t.Errorf("DeriveSmartAccount() = (%q, %v), want (0xsca, true)", scaAddr, deployed)
+	}
+	if backend.gotRegistry != "0xregistry" {
+		t.Errorf("registry = %q, want the SDK's configured default", backend.gotRegistry)
+	}
+	if backend.gotFactory != "0xfactory" {
+		t.Errorf("factory = %q, want the SDK's configured default", backend.gotFactory)
+	}
+}
+
+func TestDeriveSmartAccountHonorsExplicitRegistryAndFactory(t *testing.T) {
+	backend := &bridgeBackend{ethAddr: "0xeth", scaAddr: "0xsca"}
+	sdk := &BitcoinMobileSDK{
+		backend: backend,
+		config:  Config{EVMRegistryAddress: "0xdefault-registry", EVMFactoryAddress: "0xdefault-factory"},
+	}
+
+	if _, _, err := sdk.DeriveSmartAccount("bc1q...", "0xcustom-registry", "0xcustom-factory"); err != nil {
+		t.Fatalf("DeriveSmartAccount: %v", err)
+	}
+	if backend.gotRegistry != "0xcustom-registry" {
+		t.Errorf("registry = %q, want the explicitly passed value", backend.gotRegistry)
+	}
+	if backend.gotFactory != "0xcustom-factory" {
+		t.Errorf("factory = %q, want the explicitly passed value", backend.gotFactory)
+	}
+}
+
+func TestDeriveSmartAccountShortCircuitsOnBridgeError(t *testing.T) {
+	wantErr := errNotImplemented
+	backend := &bridgeBackend{bridgeErr: wantErr}
+	sdk := &BitcoinMobileSDK{backend: backend, config: Config{EVMFactoryAddress: "0xfactory"}}
+
+	_, _, err := sdk.DeriveSmartAccount("bc1q...", "", "")
+	if err != wantErr {
+		t.Errorf("err = %v, want %v", err, wantErr)
+	}
+	if backend.gotFactory != "" {
+		t.Errorf("DeriveSmartAccount on the backend was called despite BridgeEthAddress failing")
+	}
+}
+
+func TestDeriveSmartAccountRejectsEmptyAddress(t *testing.T) {
+	sdk := &BitcoinMobileSDK{backend: &bridgeBackend{}}
+
+	_, _, err := sdk.DeriveSmartAccount("", "", "")
+	if err != ErrInvalidAddress {
+		t.Errorf("err = %v, want ErrInvalidAddress", err)
+	}
+}
+
+func TestDeriveSmartAccountRejectsNilBackend(t *testing.T) {
+	sdk := &BitcoinMobileSDK{}
+
+	_, _, err := sdk.DeriveSmartAccount("bc1q...", "", "")
+	if err != ErrNotInitialized {
+		t.Errorf("err = %v, want ErrNotInitialized", err)
+	}
+}
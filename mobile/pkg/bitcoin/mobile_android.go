@@ -0,0 +1,15 @@
+//go:build android
+
+package mobile
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/../../target/aarch64-linux-android/release -landroid -lanya_bitcoin
+*/
+import "C"
+
+// This file only supplies the Android-specific linker flags; the actual
+// cgoBackend implementation lives in mobile_cgo.go and is shared across
+// every build target.
+func init() {
+	newCoreBackend = newCgoBackend
+}
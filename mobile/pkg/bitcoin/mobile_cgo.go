@@ -0,0 +1,183 @@
+package mobile
+
+/*
+#include "anya_bitcoin.h"
+*/
+import "C"
+import (
+	"encoding/hex"
+	"encoding/json"
+	"unsafe"
+)
+
+// cgoBackend implements coreBackend on top of the anya_bitcoin library via
+// cgo. The conversion logic here is identical across every build target;
+// only the library this package links against and how it is located
+// differs, so that part lives in the per-target mobile_android.go,
+// mobile_ios.go, and mobile_other.go files via their #cgo LDFLAGS.
+type cgoBackend struct{}
+
+func newCgoBackend() (coreBackend, error) {
+	return &cgoBackend{}, nil
+}
+
+func (b *cgoBackend) VerifySPVProof(txHash string) (*SPVProof, error) {
+	cHash := C.CString(txHash)
+	defer C.free(unsafe.Pointer(cHash))
+
+	cJSON := C.verify_spv_proof(cHash)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var proof SPVProof
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &proof); err != nil {
+		return nil, err
+	}
+	return &proof, nil
+}
+
+func (b *cgoBackend) CreateLightningInvoice(amountSat int64) (string, error) {
+	cInvoice := C.create_lightning_invoice(C.longlong(amountSat))
+	defer C.free(unsafe.Pointer(cInvoice))
+
+	return C.GoString(cInvoice), nil
+}
+
+func (b *cgoBackend) DeriveAccount(path string) (*Account, error) {
+	cPath := C.CString(path)
+	defer C.free(unsafe.Pointer(cPath))
+
+	cJSON := C.derive_account(cPath)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var account Account
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &account); err != nil {
+		return nil, err
+	}
+	return &account, nil
+}
+
+func (b *cgoBackend) InitiatePayment(req PaymentRequest) (string, error) {
+	payload, err := json.Marshal(req)
+	if err != nil {
+		return "", err
+	}
+	cReq := C.CString(string(payload))
+	defer C.free(unsafe.Pointer(cReq))
+
+	cAttemptID := C.initiate_payment(cReq)
+	defer C.free(unsafe.Pointer(cAttemptID))
+
+	return C.GoString(cAttemptID), nil
+}
+
+func (b *cgoBackend) PollPayment(attemptID string) (*PaymentUpdate, error) {
+	cAttemptID := C.CString(attemptID)
+	defer C.free(unsafe.Pointer(cAttemptID))
+
+	cJSON := C.poll_payment(cAttemptID)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var update PaymentUpdate
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &update); err != nil {
+		return nil, err
+	}
+	return &update, nil
+}
+
+func (b *cgoBackend) CancelPayment(paymentHash []byte) error {
+	cHash := C.CString(hex.EncodeToString(paymentHash))
+	defer C.free(unsafe.Pointer(cHash))
+
+	if !bool(C.cancel_payment(cHash)) {
+		return ErrPaymentNotCancelable
+	}
+	return nil
+}
+
+func (b *cgoBackend) ListPayments() ([]Payment, error) {
+	cJSON := C.list_payments()
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var payments []Payment
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &payments); err != nil {
+		return nil, err
+	}
+	return payments, nil
+}
+
+func (b *cgoBackend) DecodeInvoice(invoice string) (*DecodedInvoice, error) {
+	cInvoice := C.CString(invoice)
+	defer C.free(unsafe.Pointer(cInvoice))
+
+	cJSON := C.decode_invoice(cInvoice)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var decoded DecodedInvoice
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &decoded); err != nil {
+		return nil, err
+	}
+	return &decoded, nil
+}
+
+func (b *cgoBackend) BridgeEthAddress(btcAddr string, registryAddr string) (string, error) {
+	cBTCAddr := C.CString(btcAddr)
+	defer C.free(unsafe.Pointer(cBTCAddr))
+	cRegistry := C.CString(registryAddr)
+	defer C.free(unsafe.Pointer(cRegistry))
+
+	cEthAddr := C.bridge_eth_address(cBTCAddr, cRegistry)
+	defer C.free(unsafe.Pointer(cEthAddr))
+
+	return C.GoString(cEthAddr), nil
+}
+
+func (b *cgoBackend) DeriveSmartAccount(ethAddr string, registry string, factory string) (string, bool, error) {
+	cEthAddr := C.CString(ethAddr)
+	defer C.free(unsafe.Pointer(cEthAddr))
+	cRegistry := C.CString(registry)
+	defer C.free(unsafe.Pointer(cRegistry))
+	cFactory := C.CString(factory)
+	defer C.free(unsafe.Pointer(cFactory))
+
+	cJSON := C.derive_smart_account(cEthAddr, cRegistry, cFactory)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var result struct {
+		Address  string `json:"address"`
+		Deployed bool   `json:"deployed"`
+	}
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &result); err != nil {
+		return "", false, err
+	}
+	return result.Address, result.Deployed, nil
+}
+
+func (b *cgoBackend) SignUserOperation(btcAddr string, userOp UserOperation) ([]byte, error) {
+	payload, err := json.Marshal(userOp)
+	if err != nil {
+		return nil, err
+	}
+	cBTCAddr := C.CString(btcAddr)
+	defer C.free(unsafe.Pointer(cBTCAddr))
+	cUserOp := C.CString(string(payload))
+	defer C.free(unsafe.Pointer(cUserOp))
+
+	cSigHex := C.sign_user_operation(cBTCAddr, cUserOp)
+	defer C.free(unsafe.Pointer(cSigHex))
+
+	return hex.DecodeString(C.GoString(cSigHex))
+}
+
+func (b *cgoBackend) FindPaymentToAddress(addr string) (*AddressPayment, error) {
+	cAddr := C.CString(addr)
+	defer C.free(unsafe.Pointer(cAddr))
+
+	cJSON := C.find_payment_to_address(cAddr)
+	defer C.free(unsafe.Pointer(cJSON))
+
+	var payment AddressPayment
+	if err := json.Unmarshal([]byte(C.GoString(cJSON)), &payment); err != nil {
+		return nil, err
+	}
+	return &payment, nil
+}
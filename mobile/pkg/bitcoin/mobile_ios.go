@@ -0,0 +1,15 @@
+//go:build ios
+
+package mobile
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/../../target/aarch64-apple-ios/release -framework Security -lanya_bitcoin
+*/
+import "C"
+
+// This file only supplies the iOS-specific linker flags; the actual
+// cgoBackend implementation lives in mobile_cgo.go and is shared across
+// every build target.
+func init() {
+	newCoreBackend = newCgoBackend
+}
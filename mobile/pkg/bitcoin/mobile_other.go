@@ -0,0 +1,16 @@
+//go:build !android && !ios
+
+package mobile
+
+/*
+#cgo LDFLAGS: -L${SRCDIR}/../../target/release -lanya_bitcoin
+*/
+import "C"
+
+// This file only supplies the host-build linker flags used for desktop
+// development and `go test` runs that want to exercise the real core
+// rather than a stub; the actual cgoBackend implementation lives in
+// mobile_cgo.go and is shared across every build target.
+func init() {
+	newCoreBackend = newCgoBackend
+}
@@ -0,0 +1,90 @@
+package mobile
+
+import "strconv"
+
+// Network identifies which Bitcoin network a BitcoinMobileSDK talks to.
+type Network int
+
+const (
+	// Mainnet is the production Bitcoin network.
+	Mainnet Network = iota
+	// Testnet is the public Bitcoin test network.
+	Testnet
+	// Regtest is a private, locally mined network used for development.
+	Regtest
+)
+
+// String implements fmt.Stringer so Network prints sensibly in logs and in
+// errors surfaced to mobile callers.
+func (n Network) String() string {
+	switch n {
+	case Mainnet:
+		return "mainnet"
+	case Testnet:
+		return "testnet"
+	case Regtest:
+		return "regtest"
+	default:
+		return "unknown"
+	}
+}
+
+// NetworkParams holds the constants that differ between Bitcoin networks.
+// It mirrors the fields the Rust anya_bitcoin core needs to validate
+// addresses and encode transactions for a given network.
+type NetworkParams struct {
+	Name        string
+	Bech32HRP   string
+	P2PKHPrefix byte
+	P2SHPrefix  byte
+	CoinType    uint32
+}
+
+var (
+	// MainnetParams are the parameters for Network Mainnet.
+	MainnetParams = NetworkParams{
+		Name:        "mainnet",
+		Bech32HRP:   "bc",
+		P2PKHPrefix: 0x00,
+		P2SHPrefix:  0x05,
+		CoinType:    0,
+	}
+
+	// TestnetParams are the parameters for Network Testnet.
+	TestnetParams = NetworkParams{
+		Name:        "testnet",
+		Bech32HRP:   "tb",
+		P2PKHPrefix: 0x6f,
+		P2SHPrefix:  0xc4,
+		CoinType:    1,
+	}
+
+	// RegtestParams are the parameters for Network Regtest.
+	RegtestParams = NetworkParams{
+		Name:        "regtest",
+		Bech32HRP:   "bcrt",
+		P2PKHPrefix: 0x6f,
+		P2SHPrefix:  0xc4,
+		CoinType:    1,
+	}
+)
+
+// defaultAccountPath returns the standard BIP-84 external-chain path for
+// account 0, index 0, under the given SLIP-44 coin type.
+func defaultAccountPath(coinType uint32) string {
+	return "m/84'/" + strconv.FormatUint(uint64(coinType), 10) + "'/0'/0/0"
+}
+
+// paramsForNetwork returns the NetworkParams for n, defaulting to
+// MainnetParams for an unrecognized value so the SDK never operates with a
+// zero-value, all-empty parameter set.
+func paramsForNetwork(n Network) NetworkParams {
+	switch n {
+	case Testnet:
+		return TestnetParams
+	case Regtest:
+		return RegtestParams
+	default:
+		return MainnetParams
+	}
+}
@@ -0,0 +1,110 @@
+package mobile
+
+import "testing"
+
+func TestOnChainStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		req     ExpectedPaymentRequest
+		payment *AddressPayment
+		proof   *SPVProof
+		want    WatchStatus
+	}{
+		{
+			name:    "partially paid",
+			req:     ExpectedPaymentRequest{AmountSat: 1000},
+			payment: &AddressPayment{Found: true, ReceivedSat: 500},
+			proof:   &SPVProof{Confirmations: 6},
+			want:    WatchPartiallyPaid,
+		},
+		{
+			name:    "detected but under required confirmations",
+			req:     ExpectedPaymentRequest{AmountSat: 1000, RequiredConfirmations: 3},
+			payment: &AddressPayment{Found: true, ReceivedSat: 1000},
+			proof:   &SPVProof{Confirmations: 1},
+			want:    WatchDetected,
+		},
+		{
+			name:    "confirmed once required confirmations are met",
+			req:     ExpectedPaymentRequest{AmountSat: 1000, RequiredConfirmations: 3},
+			payment: &AddressPayment{Found: true, ReceivedSat: 1000},
+			proof:   &SPVProof{Confirmations: 3},
+			want:    WatchConfirmed,
+		},
+		{
+			name:    "zero RequiredConfirmations defaults to one",
+			req:     ExpectedPaymentRequest{AmountSat: 1000},
+			payment: &AddressPayment{Found: true, ReceivedSat: 1000},
+			proof:   &SPVProof{Confirmations: 1},
+			want:    WatchConfirmed,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := onChainStatus(tt.req, tt.payment, tt.proof); got != tt.want {
+				t.Errorf("onChainStatus() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+// lightningCheckerBackend is a coreBackend stub that only implements
+// ListPayments, for tests driving checkLightning.
+type lightningCheckerBackend struct {
+	fakeBackend
+	payments []Payment
+}
+
+func (b *lightningCheckerBackend) ListPayments() ([]Payment, error) {
+	return b.payments, nil
+}
+
+func TestCheckLightningMatchesByPaymentHash(t *testing.T) {
+	hash := []byte{1, 2, 3, 4}
+	backend := &lightningCheckerBackend{payments: []Payment{
+		{PaymentHash: []byte{9, 9, 9, 9}, Status: PaymentSucceeded},
+		{PaymentHash: hash, Status: PaymentSucceeded, AmountSat: 1500},
+	}}
+	pc := &PaymentChecker{sdk: &BitcoinMobileSDK{backend: backend}, store: newMemWatchStore()}
+
+	w := &watch{ID: "w1", Kind: WatchLightning, Request: ExpectedPaymentRequest{PaymentHash: hash}, Status: WatchPending}
+	pc.checkLightning(w)
+
+	if w.Status != WatchConfirmed {
+		t.Errorf("status = %v, want WatchConfirmed", w.Status)
+	}
+	if w.ReceivedSat != 1500 {
+		t.Errorf("ReceivedSat = %d, want 1500", w.ReceivedSat)
+	}
+}
+
+func TestCheckLightningLeavesUnmatchedWatchPending(t *testing.T) {
+	hash := []byte{1, 2, 3, 4}
+	backend := &lightningCheckerBackend{payments: []Payment{
+		{PaymentHash: []byte{9, 9, 9, 9}, Status: PaymentSucceeded},
+	}}
+	pc := &PaymentChecker{sdk: &BitcoinMobileSDK{backend: backend}, store: newMemWatchStore()}
+
+	w := &watch{ID: "w1", Kind: WatchLightning, Request: ExpectedPaymentRequest{PaymentHash: hash}, Status: WatchPending}
+	pc.checkLightning(w)
+
+	if w.Status != WatchPending {
+		t.Errorf("status = %v, want WatchPending (unchanged)", w.Status)
+	}
+}
+
+func TestCheckLightningWaitsOnInFlightMatch(t *testing.T) {
+	hash := []byte{1, 2, 3, 4}
+	backend := &lightningCheckerBackend{payments: []Payment{
+		{PaymentHash: hash, Status: PaymentInFlight},
+	}}
+	pc := &PaymentChecker{sdk: &BitcoinMobileSDK{backend: backend}, store: newMemWatchStore()}
+
+	w := &watch{ID: "w1", Kind: WatchLightning, Request: ExpectedPaymentRequest{PaymentHash: hash}, Status: WatchPending}
+	pc.checkLightning(w)
+
+	if w.Status != WatchPending {
+		t.Errorf("status = %v, want WatchPending while payment is still in flight", w.Status)
+	}
+}
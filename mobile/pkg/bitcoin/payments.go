@@ -0,0 +1,273 @@
+package mobile
+
+import (
+	"context"
+	"encoding/hex"
+	"time"
+)
+
+// PaymentStatus mirrors the states lnd's routerrpc.SendPaymentV2 reports
+// for a payment attempt.
+type PaymentStatus int
+
+const (
+	// PaymentInFlight means the payment has been dispatched and is
+	// awaiting settlement.
+	PaymentInFlight PaymentStatus = iota
+	// PaymentSucceeded means the payment settled.
+	PaymentSucceeded
+	// PaymentFailed means the payment terminally failed; see
+	// PaymentUpdate.FailureReason for why.
+	PaymentFailed
+)
+
+// FailureReason enumerates why a payment failed, mirroring lnd's
+// lnrpc.PaymentFailureReason.
+type FailureReason int
+
+const (
+	// FailureReasonNone is the zero value, used when a payment has not
+	// failed.
+	FailureReasonNone FailureReason = iota
+	// FailureReasonIncorrectPaymentDetails means the receiver rejected
+	// the payment because the amount or payment secret didn't match.
+	FailureReasonIncorrectPaymentDetails
+	// FailureReasonInsufficientBalance means the sender didn't have
+	// enough local channel balance to complete the payment.
+	FailureReasonInsufficientBalance
+	// FailureReasonNoRoute means no route to the destination could be
+	// found within the fee and hop-count limits given.
+	FailureReasonNoRoute
+	// FailureReasonTimeout means the payment did not resolve within
+	// PaymentRequest.TimeoutSeconds.
+	FailureReasonTimeout
+)
+
+// PaymentRequest describes a Lightning payment to send.
+type PaymentRequest struct {
+	// Invoice is the BOLT11 invoice to pay.
+	Invoice string
+
+	// TimeoutSeconds bounds how long SendPayment will keep retrying
+	// before giving up with FailureReasonTimeout. Zero means 60 seconds.
+	TimeoutSeconds int32
+
+	// FeeLimitSat caps the total routing fee the payment may pay.
+	FeeLimitSat int64
+
+	// MaxParts bounds how many parts an MPP (multi-part payment) may
+	// split across. Zero means the core's default.
+	MaxParts int32
+
+	// AllowSelfPayment permits paying an invoice this SDK instance
+	// itself created.
+	AllowSelfPayment bool
+}
+
+// PaymentUpdate is one status update in a payment's lifecycle, delivered to
+// a PaymentUpdateObserver or over the channel sendPaymentChan and
+// trackPaymentChan return.
+type PaymentUpdate struct {
+	PaymentHash   []byte
+	Status        PaymentStatus
+	FailureReason FailureReason
+	FeeSat        int64
+}
+
+// PaymentUpdateObserver receives a dispatched or tracked payment's status
+// updates. Mobile apps implement it as a gomobile callback interface;
+// gobind cannot translate a Go channel, so this - not sendPaymentChan /
+// trackPaymentChan's channel - is the gomobile-facing surface for
+// SendPayment and TrackPayment, mirroring how PaymentObserver fronts
+// PaymentChecker's watches.
+type PaymentUpdateObserver interface {
+	// OnPaymentUpdate fires for every status update the payment reaches
+	// over the course of being sent or tracked.
+	OnPaymentUpdate(paymentHash []byte, status PaymentStatus, failureReason FailureReason, feeSat int64)
+}
+
+// Payment is a historical or in-flight payment as reported by
+// ListPayments.
+type Payment struct {
+	PaymentHash []byte
+	Invoice     string
+	AmountSat   int64
+	Status      PaymentStatus
+	CreatedUnix int64
+}
+
+// DecodedInvoice is the result of decoding a BOLT11 invoice without
+// paying it.
+type DecodedInvoice struct {
+	PaymentHash []byte
+	AmountSat   int64
+	Description string
+	ExpiryUnix  int64
+	Destination string
+}
+
+// pollInterval is the starting delay between payment status polls; it
+// doubles on each successive poll up to pollIntervalMax.
+const (
+	pollInterval    = 250 * time.Millisecond
+	pollIntervalMax = 5 * time.Second
+)
+
+// SendPayment dispatches req and notifies observer of every status update
+// until the payment reaches a terminal state or ctx is done. The Go layer
+// owns a retry/backoff loop around the Rust FFI's InitiatePayment and
+// PollPayment calls so the core only needs to expose simple
+// request/response primitives, and mobile UIs get at-least-once delivery
+// of status updates without blocking on the core.
+func (sdk *BitcoinMobileSDK) SendPayment(ctx context.Context, req PaymentRequest, observer PaymentUpdateObserver) error {
+	updates, err := sdk.sendPaymentChan(ctx, req)
+	if err != nil {
+		return err
+	}
+	go deliverPaymentUpdates(updates, observer)
+	return nil
+}
+
+// TrackPayment resumes following an already-dispatched payment by its
+// payment hash, for example after the app restarts mid-payment, notifying
+// observer the same way SendPayment does.
+func (sdk *BitcoinMobileSDK) TrackPayment(paymentHash []byte, observer PaymentUpdateObserver) error {
+	updates, err := sdk.trackPaymentChan(paymentHash)
+	if err != nil {
+		return err
+	}
+	go deliverPaymentUpdates(updates, observer)
+	return nil
+}
+
+// deliverPaymentUpdates drains updates, forwarding each one to observer,
+// until the channel is closed.
+func deliverPaymentUpdates(updates <-chan PaymentUpdate, observer PaymentUpdateObserver) {
+	for update := range updates {
+		if observer != nil {
+			observer.OnPaymentUpdate(update.PaymentHash, update.Status, update.FailureReason, update.FeeSat)
+		}
+	}
+}
+
+// sendPaymentChan is the channel-based implementation behind SendPayment.
+// It stays unexported because gobind cannot translate a Go channel into
+// Java/Obj-C; server-side Go code and tests that want the raw channel can
+// call it directly instead of going through a PaymentUpdateObserver.
+func (sdk *BitcoinMobileSDK) sendPaymentChan(ctx context.Context, req PaymentRequest) (<-chan PaymentUpdate, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if req.Invoice == "" {
+		return nil, ErrInvalidInvoice
+	}
+
+	attemptID, err := sdk.backend.InitiatePayment(req)
+	if err != nil {
+		return nil, err
+	}
+
+	timeout := time.Duration(req.TimeoutSeconds) * time.Second
+	if timeout <= 0 {
+		timeout = 60 * time.Second
+	}
+
+	updates := make(chan PaymentUpdate, 1)
+	go sdk.pollPayment(ctx, attemptID, timeout, updates)
+	return updates, nil
+}
+
+// trackPaymentChan is the channel-based implementation behind TrackPayment;
+// see sendPaymentChan for why it stays unexported.
+func (sdk *BitcoinMobileSDK) trackPaymentChan(paymentHash []byte) (<-chan PaymentUpdate, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if len(paymentHash) == 0 {
+		return nil, ErrInvalidPaymentHash
+	}
+
+	updates := make(chan PaymentUpdate, 1)
+	go sdk.pollPayment(context.Background(), hex.EncodeToString(paymentHash), 0, updates)
+	return updates, nil
+}
+
+// pollPayment polls the backend for attemptID's status with exponential
+// backoff until it reaches a terminal state, ctx is done, or timeout
+// elapses (a timeout of zero means no deadline), emitting every update it
+// observes and closing updates when it returns.
+func (sdk *BitcoinMobileSDK) pollPayment(ctx context.Context, attemptID string, timeout time.Duration, updates chan<- PaymentUpdate) {
+	defer close(updates)
+
+	var deadline <-chan time.Time
+	if timeout > 0 {
+		timer := time.NewTimer(timeout)
+		defer timer.Stop()
+		deadline = timer.C
+	}
+
+	delay := pollInterval
+	for {
+		update, err := sdk.backend.PollPayment(attemptID)
+		if err == nil {
+			select {
+			case updates <- *update:
+			case <-ctx.Done():
+				return
+			}
+			if update.Status != PaymentInFlight {
+				return
+			}
+		}
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-deadline:
+			select {
+			case updates <- PaymentUpdate{Status: PaymentFailed, FailureReason: FailureReasonTimeout}:
+			case <-ctx.Done():
+			}
+			return
+		case <-time.After(delay):
+		}
+
+		if delay < pollIntervalMax {
+			delay *= 2
+			if delay > pollIntervalMax {
+				delay = pollIntervalMax
+			}
+		}
+	}
+}
+
+// ListPayments returns all payments known to the core, most recent first.
+func (sdk *BitcoinMobileSDK) ListPayments() ([]Payment, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	return sdk.backend.ListPayments()
+}
+
+// DecodeInvoice decodes a BOLT11 invoice without paying it.
+func (sdk *BitcoinMobileSDK) DecodeInvoice(invoice string) (*DecodedInvoice, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if invoice == "" {
+		return nil, ErrInvalidInvoice
+	}
+	return sdk.backend.DecodeInvoice(invoice)
+}
+
+// CancelInvoice cancels an in-flight payment identified by its payment
+// hash, if the core still considers it cancelable.
+func (sdk *BitcoinMobileSDK) CancelInvoice(paymentHash []byte) error {
+	if sdk.backend == nil {
+		return ErrNotInitialized
+	}
+	if len(paymentHash) == 0 {
+		return ErrInvalidPaymentHash
+	}
+	return sdk.backend.CancelPayment(paymentHash)
+}
@@ -0,0 +1,151 @@
+package mobile
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+// fakeBackend is a coreBackend stub that lets tests drive PollPayment's
+// return values without linking the cgo core. InitiatePayment always
+// succeeds with "attempt-1"; every other method returns errNotImplemented
+// since the retry/backoff tests here only exercise pollPayment.
+type fakeBackend struct {
+	mu        sync.Mutex
+	pollCalls int
+	pollFunc  func(call int) (*PaymentUpdate, error)
+}
+
+var errNotImplemented = errors.New("fakeBackend: not implemented")
+
+func (f *fakeBackend) callCount() int {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return f.pollCalls
+}
+
+func (f *fakeBackend) VerifySPVProof(string) (*SPVProof, error) { return nil, errNotImplemented }
+func (f *fakeBackend) CreateLightningInvoice(int64) (string, error) {
+	return "", errNotImplemented
+}
+func (f *fakeBackend) DeriveAccount(string) (*Account, error) { return nil, errNotImplemented }
+func (f *fakeBackend) InitiatePayment(PaymentRequest) (string, error) {
+	return "attempt-1", nil
+}
+
+func (f *fakeBackend) PollPayment(attemptID string) (*PaymentUpdate, error) {
+	f.mu.Lock()
+	f.pollCalls++
+	call := f.pollCalls
+	f.mu.Unlock()
+	return f.pollFunc(call)
+}
+
+func (f *fakeBackend) CancelPayment([]byte) error       { return errNotImplemented }
+func (f *fakeBackend) ListPayments() ([]Payment, error) { return nil, errNotImplemented }
+func (f *fakeBackend) DecodeInvoice(string) (*DecodedInvoice, error) {
+	return nil, errNotImplemented
+}
+func (f *fakeBackend) BridgeEthAddress(string, string) (string, error) {
+	return "", errNotImplemented
+}
+func (f *fakeBackend) DeriveSmartAccount(string, string, string) (string, bool, error) {
+	return "", false, errNotImplemented
+}
+func (f *fakeBackend) SignUserOperation(string, UserOperation) ([]byte, error) {
+	return nil, errNotImplemented
+}
+func (f *fakeBackend) FindPaymentToAddress(string) (*AddressPayment, error) {
+	return nil, errNotImplemented
+}
+
+func TestPollPaymentRetriesTransientErrorsThenSucceeds(t *testing.T) {
+	backend := &fakeBackend{
+		pollFunc: func(call int) (*PaymentUpdate, error) {
+			if call < 3 {
+				return nil, errors.New("transient rpc error")
+			}
+			return &PaymentUpdate{Status: PaymentSucceeded, FeeSat: 5}, nil
+		},
+	}
+	sdk := &BitcoinMobileSDK{backend: backend}
+
+	updates := make(chan PaymentUpdate, 8)
+	done := make(chan struct{})
+	go func() {
+		sdk.pollPayment(context.Background(), "attempt-1", 3*time.Second, updates)
+		close(done)
+	}()
+
+	select {
+	case update := <-updates:
+		if update.Status != PaymentSucceeded || update.FeeSat != 5 {
+			t.Fatalf("update = %+v, want PaymentSucceeded with FeeSat 5", update)
+		}
+	case <-time.After(3 * time.Second):
+		t.Fatal("timed out waiting for an update")
+	}
+
+	<-done
+	if calls := backend.callCount(); calls < 3 {
+		t.Errorf("PollPayment called %d times, want at least 3 (two transient errors then success)", calls)
+	}
+}
+
+func TestPollPaymentTimesOut(t *testing.T) {
+	backend := &fakeBackend{
+		pollFunc: func(int) (*PaymentUpdate, error) {
+			return &PaymentUpdate{Status: PaymentInFlight}, nil
+		},
+	}
+	sdk := &BitcoinMobileSDK{backend: backend}
+
+	updates := make(chan PaymentUpdate, 8)
+	done := make(chan struct{})
+	go func() {
+		sdk.pollPayment(context.Background(), "attempt-1", 300*time.Millisecond, updates)
+		close(done)
+	}()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("pollPayment did not return once its timeout elapsed")
+	}
+
+	var last PaymentUpdate
+	for update := range updates {
+		last = update
+	}
+	if last.Status != PaymentFailed || last.FailureReason != FailureReasonTimeout {
+		t.Errorf("final update = %+v, want PaymentFailed/FailureReasonTimeout", last)
+	}
+}
+
+func TestPollPaymentStopsOnContextCancel(t *testing.T) {
+	backend := &fakeBackend{
+		pollFunc: func(int) (*PaymentUpdate, error) {
+			return &PaymentUpdate{Status: PaymentInFlight}, nil
+		},
+	}
+	sdk := &BitcoinMobileSDK{backend: backend}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	updates := make(chan PaymentUpdate, 8)
+	done := make(chan struct{})
+	go func() {
+		sdk.pollPayment(ctx, "attempt-1", 0, updates)
+		close(done)
+	}()
+
+	time.Sleep(50 * time.Millisecond)
+	cancel()
+
+	select {
+	case <-done:
+	case <-time.After(3 * time.Second):
+		t.Fatal("pollPayment did not return after its context was canceled")
+	}
+}
@@ -0,0 +1,430 @@
+package mobile
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"errors"
+	"fmt"
+)
+
+// beefMagic is the BRC-62 BEEF version prefix, 0100BEEF.
+var beefMagic = [4]byte{0x01, 0x00, 0xBE, 0xEF}
+
+// ErrInvalidBUMP and ErrInvalidBEEF are returned when decoding malformed
+// proof bundles.
+var (
+	ErrInvalidBUMP = errors.New("mobile: invalid BUMP encoding")
+	ErrInvalidBEEF = errors.New("mobile: invalid BEEF encoding")
+)
+
+// BUMPFlag tags how to interpret a BUMPNode's Hash field, per BRC-74.
+type BUMPFlag byte
+
+const (
+	// BUMPData means Hash carries a real node hash.
+	BUMPData BUMPFlag = iota
+	// BUMPDuplicate means this node's hash equals its sibling's, so no
+	// hash is stored; the verifier duplicates the sibling.
+	BUMPDuplicate
+	// BUMPClientTxID means Hash is the txid the client asked to prove,
+	// rather than an internal Merkle node.
+	BUMPClientTxID
+)
+
+// BUMPNode is one hash entry at a given Merkle tree level of a BUMP.
+type BUMPNode struct {
+	// Offset is this node's index within its level.
+	Offset uint64
+	Flag   BUMPFlag
+	// Hash is the node's hash, omitted (nil) when Flag is BUMPDuplicate.
+	Hash []byte
+}
+
+// BUMP is a BRC-74 BSV Unified Merkle Path: the minimal set of hashes
+// needed to recompute a block's Merkle root for one or more of its
+// transactions.
+type BUMP struct {
+	BlockHeight uint64
+	// Path[0] holds the leaves (transaction-level hashes); each
+	// subsequent level holds the nodes needed to climb one step closer
+	// to the root.
+	Path [][]BUMPNode
+}
+
+// SPVProof carries everything a caller needs to both trust and forward
+// proof that a transaction is included in the chain.
+type SPVProof struct {
+	TxHash        string
+	BlockHeader   []byte
+	Height        uint32
+	Confirmations uint32
+	Path          BUMP
+}
+
+// VerifySPVProof checks txHash's inclusion in the chain and returns the
+// structured Merkle proof of it, suitable for forwarding to a counterparty
+// via EncodeBUMP or EncodeBEEF.
+func (sdk *BitcoinMobileSDK) VerifySPVProof(txHash string) (*SPVProof, error) {
+	if sdk.backend == nil {
+		return nil, ErrNotInitialized
+	}
+	if raw, err := hex.DecodeString(txHash); err != nil || len(raw) != sha256.Size {
+		return nil, ErrInvalidTxHash
+	}
+	return sdk.backend.VerifySPVProof(txHash)
+}
+
+// EncodeBUMP encodes proof.Path as a BRC-74 BUMP byte blob.
+func EncodeBUMP(proof *SPVProof) ([]byte, error) {
+	if proof == nil {
+		return nil, ErrInvalidBUMP
+	}
+
+	var buf bytes.Buffer
+	writeVarInt(&buf, proof.Path.BlockHeight)
+	writeVarInt(&buf, uint64(len(proof.Path.Path)))
+
+	for _, level := range proof.Path.Path {
+		writeVarInt(&buf, uint64(len(level)))
+		for _, node := range level {
+			writeVarInt(&buf, node.Offset)
+			buf.WriteByte(byte(node.Flag))
+			if node.Flag != BUMPDuplicate {
+				if len(node.Hash) != sha256.Size {
+					return nil, fmt.Errorf("%w: node hash must be %d bytes", ErrInvalidBUMP, sha256.Size)
+				}
+				buf.Write(node.Hash)
+			}
+		}
+	}
+	return buf.Bytes(), nil
+}
+
+// DecodeBUMP parses a BRC-74 BUMP byte blob produced by EncodeBUMP.
+func DecodeBUMP(data []byte) (*BUMP, error) {
+	bump, _, err := decodeBUMPPrefix(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidBUMP, err)
+	}
+	return bump, nil
+}
+
+// EncodeBEEF packs txs (raw transactions, in dependency order) and their
+// corresponding proofs (nil for a tx that carries no BUMP of its own; it is
+// written with has_bump 0) into a BRC-62 BEEF blob.
+func EncodeBEEF(txs [][]byte, proofs []*SPVProof) ([]byte, error) {
+	if len(txs) != len(proofs) {
+		return nil, fmt.Errorf("%w: txs and proofs must be the same length", ErrInvalidBEEF)
+	}
+
+	var buf bytes.Buffer
+	buf.Write(beefMagic[:])
+
+	// Collect the distinct BUMPs referenced by proofs, in first-seen
+	// order, so each is only embedded once even if several txs share a
+	// block.
+	var bumps []*BUMP
+	bumpIndex := make(map[uint64]int)
+	txBumpIdx := make([]int, len(txs))
+	for i, proof := range proofs {
+		if proof == nil {
+			txBumpIdx[i] = -1
+			continue
+		}
+		idx, ok := bumpIndex[proof.Path.BlockHeight]
+		if !ok {
+			idx = len(bumps)
+			bumpIndex[proof.Path.BlockHeight] = idx
+			bumps = append(bumps, &proof.Path)
+		}
+		txBumpIdx[i] = idx
+	}
+
+	writeVarInt(&buf, uint64(len(bumps)))
+	for _, b := range bumps {
+		encoded, err := EncodeBUMP(&SPVProof{Path: *b})
+		if err != nil {
+			return nil, err
+		}
+		buf.Write(encoded)
+	}
+
+	writeVarInt(&buf, uint64(len(txs)))
+	for i, tx := range txs {
+		if txBumpIdx[i] >= 0 {
+			buf.WriteByte(1) // has_bump
+			writeVarInt(&buf, uint64(txBumpIdx[i]))
+		} else {
+			buf.WriteByte(0)
+		}
+		writeVarInt(&buf, uint64(len(tx)))
+		buf.Write(tx)
+	}
+	return buf.Bytes(), nil
+}
+
+// HeaderSource supplies a block's Merkle root by height so VerifyBEEF can
+// confirm an embedded BUMP actually recomputes to the root of the block it
+// claims to be in.
+type HeaderSource interface {
+	MerkleRootAtHeight(height uint64) ([]byte, error)
+}
+
+// VerifiedTx is one transaction VerifyBEEF proved (or failed to prove)
+// inclusion for.
+type VerifiedTx struct {
+	TxID   string
+	Height uint64
+	Proven bool
+}
+
+// VerifyBEEF walks a BRC-62 BEEF bundle, validates each transaction's
+// embedded BUMP against headers, and reports which txids were proven.
+func VerifyBEEF(blob []byte, headers HeaderSource) ([]VerifiedTx, error) {
+	if len(blob) < len(beefMagic) || !bytes.Equal(blob[:len(beefMagic)], beefMagic[:]) {
+		return nil, fmt.Errorf("%w: bad magic", ErrInvalidBEEF)
+	}
+	r := bytes.NewReader(blob[len(beefMagic):])
+
+	nBumps, err := readCappedVarInt(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: bump count: %v", ErrInvalidBEEF, err)
+	}
+	bumps := make([]*BUMP, nBumps)
+	for i := range bumps {
+		// DecodeBUMP needs the remaining bytes of r; decode through a
+		// sub-reader that tracks how much it consumed so we can advance r.
+		remaining := r.Len()
+		data := make([]byte, remaining)
+		if _, err := r.Read(data); err != nil {
+			return nil, fmt.Errorf("%w: bump %d: %v", ErrInvalidBEEF, i, err)
+		}
+		bump, consumed, err := decodeBUMPPrefix(data)
+		if err != nil {
+			return nil, fmt.Errorf("%w: bump %d: %v", ErrInvalidBEEF, i, err)
+		}
+		bumps[i] = bump
+		// Rewind r to just past the bytes this BUMP consumed.
+		r = bytes.NewReader(data[consumed:])
+	}
+
+	nTxs, err := readCappedVarInt(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: tx count: %v", ErrInvalidBEEF, err)
+	}
+
+	results := make([]VerifiedTx, 0, nTxs)
+	for i := uint64(0); i < nTxs; i++ {
+		hasBump, err := r.ReadByte()
+		if err != nil {
+			return nil, fmt.Errorf("%w: has_bump: %v", ErrInvalidBEEF, err)
+		}
+		var bumpIdx uint64
+		if hasBump == 1 {
+			bumpIdx, err = readVarInt(r)
+			if err != nil {
+				return nil, fmt.Errorf("%w: bump index: %v", ErrInvalidBEEF, err)
+			}
+		}
+		txLen, err := readCappedVarInt(r)
+		if err != nil {
+			return nil, fmt.Errorf("%w: tx length: %v", ErrInvalidBEEF, err)
+		}
+		tx := make([]byte, txLen)
+		if _, err := r.Read(tx); err != nil {
+			return nil, fmt.Errorf("%w: tx bytes: %v", ErrInvalidBEEF, err)
+		}
+
+		hash := sha256d(tx)
+		result := VerifiedTx{TxID: txid(tx)}
+		if hasBump == 1 && int(bumpIdx) < len(bumps) {
+			bump := bumps[bumpIdx]
+			result.Height = bump.BlockHeight
+			root, err := bump.computeRoot(hash)
+			if err == nil && headers != nil {
+				wantRoot, err := headers.MerkleRootAtHeight(bump.BlockHeight)
+				result.Proven = err == nil && bytes.Equal(root, wantRoot)
+			}
+		}
+		results = append(results, result)
+	}
+	return results, nil
+}
+
+// decodeBUMPPrefix decodes one BUMP from the front of data and reports how
+// many bytes it consumed, so callers can decode a concatenated sequence of
+// BUMPs without a length prefix between them (as BEEF stores them).
+func decodeBUMPPrefix(data []byte) (*BUMP, int, error) {
+	r := bytes.NewReader(data)
+
+	height, err := readVarInt(r)
+	if err != nil {
+		return nil, 0, err
+	}
+	nLevels, err := readCappedVarInt(r)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	bump := &BUMP{BlockHeight: height, Path: make([][]BUMPNode, nLevels)}
+	for i := range bump.Path {
+		nNodes, err := readCappedVarInt(r)
+		if err != nil {
+			return nil, 0, err
+		}
+		nodes := make([]BUMPNode, nNodes)
+		for j := range nodes {
+			offset, err := readVarInt(r)
+			if err != nil {
+				return nil, 0, err
+			}
+			flagByte, err := r.ReadByte()
+			if err != nil {
+				return nil, 0, err
+			}
+			node := BUMPNode{Offset: offset, Flag: BUMPFlag(flagByte)}
+			if node.Flag != BUMPDuplicate {
+				hash := make([]byte, sha256.Size)
+				if _, err := r.Read(hash); err != nil {
+					return nil, 0, err
+				}
+				node.Hash = hash
+			}
+			nodes[j] = node
+		}
+		bump.Path[i] = nodes
+	}
+	return bump, len(data) - r.Len(), nil
+}
+
+// computeRoot climbs b's path from the leaf matching txid to the Merkle
+// root, duplicating hashes where a level marks BUMPDuplicate.
+func (b *BUMP) computeRoot(txid []byte) ([]byte, error) {
+	if len(b.Path) == 0 {
+		return nil, fmt.Errorf("%w: empty path", ErrInvalidBUMP)
+	}
+
+	var current []byte
+	var offset uint64
+	found := false
+	for _, leaf := range b.Path[0] {
+		if leaf.Flag == BUMPClientTxID || bytes.Equal(leaf.Hash, txid) {
+			current = txid
+			offset = leaf.Offset
+			found = true
+			break
+		}
+	}
+	if !found {
+		return nil, fmt.Errorf("%w: txid not present in leaf level", ErrInvalidBUMP)
+	}
+
+	for _, level := range b.Path[1:] {
+		var sibling []byte
+		siblingOffset := offset ^ 1
+		for _, node := range level {
+			if node.Offset == siblingOffset {
+				if node.Flag == BUMPDuplicate {
+					sibling = current
+				} else {
+					sibling = node.Hash
+				}
+				break
+			}
+		}
+		if sibling == nil {
+			return nil, fmt.Errorf("%w: missing sibling at offset %d", ErrInvalidBUMP, siblingOffset)
+		}
+
+		if offset%2 == 0 {
+			current = sha256d(append(append([]byte{}, current...), sibling...))
+		} else {
+			current = sha256d(append(append([]byte{}, sibling...), current...))
+		}
+		offset /= 2
+	}
+	return current, nil
+}
+
+// sha256d is Bitcoin's double-SHA256.
+func sha256d(data []byte) []byte {
+	first := sha256.Sum256(data)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+// txid computes a transaction's txid (double-SHA256 of its raw bytes, byte
+// reversed to match Bitcoin's little-endian display convention) and
+// returns it as a lowercase hex string.
+func txid(rawTx []byte) string {
+	h := sha256d(rawTx)
+	for i, j := 0, len(h)-1; i < j; i, j = i+1, j-1 {
+		h[i], h[j] = h[j], h[i]
+	}
+	return fmt.Sprintf("%x", h)
+}
+
+// writeVarInt writes n as a Bitcoin CompactSize integer.
+func writeVarInt(buf *bytes.Buffer, n uint64) {
+	switch {
+	case n < 0xfd:
+		buf.WriteByte(byte(n))
+	case n <= 0xffff:
+		buf.WriteByte(0xfd)
+		binary.Write(buf, binary.LittleEndian, uint16(n))
+	case n <= 0xffffffff:
+		buf.WriteByte(0xfe)
+		binary.Write(buf, binary.LittleEndian, uint32(n))
+	default:
+		buf.WriteByte(0xff)
+		binary.Write(buf, binary.LittleEndian, n)
+	}
+}
+
+// readCappedVarInt reads a CompactSize integer that is about to be used to
+// size an allocation (a node count, tx count, or tx length) and rejects any
+// value exceeding the bytes remaining in r. Without this, a crafted blob
+// with e.g. a 0xff-prefixed varint near math.MaxUint64 would reach a
+// make([]T, n) call and panic instead of returning a decode error.
+func readCappedVarInt(r *bytes.Reader) (uint64, error) {
+	n, err := readVarInt(r)
+	if err != nil {
+		return 0, err
+	}
+	if n > uint64(r.Len()) {
+		return 0, fmt.Errorf("count %d exceeds %d remaining bytes", n, r.Len())
+	}
+	return n, nil
+}
+
+// readVarInt reads a Bitcoin CompactSize integer.
+func readVarInt(r *bytes.Reader) (uint64, error) {
+	prefix, err := r.ReadByte()
+	if err != nil {
+		return 0, err
+	}
+	switch prefix {
+	case 0xfd:
+		var v uint16
+		if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+			return 0, err
+		}
+		return uint64(v), nil
+	case 0xfe:
+		var v uint32
+		if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+			return 0, err
+		}
+		return uint64(v), nil
+	case 0xff:
+		var v uint64
+		if err := binary.Read(r, binary.LittleEndian, &v); err != nil {
+			return 0, err
+		}
+		return v, nil
+	default:
+		return uint64(prefix), nil
+	}
+}
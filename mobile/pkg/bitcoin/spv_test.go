@@ -0,0 +1,121 @@
+package mobile
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+)
+
+// fakeHeaderSource is a HeaderSource backed by a fixed height->root map, for
+// tests that don't need a real chain.
+type fakeHeaderSource map[uint64][]byte
+
+func (f fakeHeaderSource) MerkleRootAtHeight(height uint64) ([]byte, error) {
+	root, ok := f[height]
+	if !ok {
+		return nil, errors.New("unknown height")
+	}
+	return root, nil
+}
+
+// singleTxBUMP builds the smallest non-trivial BUMP: one proven leaf at
+// targetOffset and its sibling one level up, recomputing to root.
+func singleTxBUMP(height uint64, leafHash, siblingHash []byte, targetOffset uint64) (*BUMP, []byte) {
+	bump := &BUMP{
+		BlockHeight: height,
+		Path: [][]BUMPNode{
+			{{Offset: targetOffset, Flag: BUMPData, Hash: leafHash}},
+			{{Offset: targetOffset ^ 1, Flag: BUMPData, Hash: siblingHash}},
+		},
+	}
+
+	var root []byte
+	if targetOffset%2 == 0 {
+		root = sha256d(append(append([]byte{}, leafHash...), siblingHash...))
+	} else {
+		root = sha256d(append(append([]byte{}, siblingHash...), leafHash...))
+	}
+	return bump, root
+}
+
+func TestEncodeDecodeBUMPRoundTrip(t *testing.T) {
+	leafHash := sha256d([]byte("leaf"))
+	siblingHash := sha256d([]byte("sibling"))
+	bump, _ := singleTxBUMP(100, leafHash, siblingHash, 0)
+
+	encoded, err := EncodeBUMP(&SPVProof{Path: *bump})
+	if err != nil {
+		t.Fatalf("EncodeBUMP: %v", err)
+	}
+
+	decoded, err := DecodeBUMP(encoded)
+	if err != nil {
+		t.Fatalf("DecodeBUMP: %v", err)
+	}
+	if decoded.BlockHeight != bump.BlockHeight {
+		t.Errorf("BlockHeight = %d, want %d", decoded.BlockHeight, bump.BlockHeight)
+	}
+	if len(decoded.Path) != len(bump.Path) {
+		t.Fatalf("got %d levels, want %d", len(decoded.Path), len(bump.Path))
+	}
+	for lvl, nodes := range bump.Path {
+		if len(decoded.Path[lvl]) != len(nodes) {
+			t.Fatalf("level %d: got %d nodes, want %d", lvl, len(decoded.Path[lvl]), len(nodes))
+		}
+		for i, node := range nodes {
+			got := decoded.Path[lvl][i]
+			if got.Offset != node.Offset || got.Flag != node.Flag || !bytes.Equal(got.Hash, node.Hash) {
+				t.Errorf("level %d node %d = %+v, want %+v", lvl, i, got, node)
+			}
+		}
+	}
+}
+
+func TestEncodeVerifyBEEFRoundTrip(t *testing.T) {
+	rawTx := []byte("a fake raw transaction, only hashed for this test")
+	leafHash := sha256d(rawTx)
+	siblingHash := sha256d([]byte("sibling tx"))
+	bump, root := singleTxBUMP(200, leafHash, siblingHash, 0)
+	proof := &SPVProof{Path: *bump}
+
+	// rawTx carries a proof; unprovenTx shares no BUMP at all.
+	unprovenTx := []byte("an unrelated transaction with no proof")
+	encoded, err := EncodeBEEF([][]byte{rawTx, unprovenTx}, []*SPVProof{proof, nil})
+	if err != nil {
+		t.Fatalf("EncodeBEEF: %v", err)
+	}
+
+	headers := fakeHeaderSource{bump.BlockHeight: root}
+	results, err := VerifyBEEF(encoded, headers)
+	if err != nil {
+		t.Fatalf("VerifyBEEF: %v", err)
+	}
+	if len(results) != 2 {
+		t.Fatalf("got %d results, want 2", len(results))
+	}
+	if !results[0].Proven || results[0].Height != bump.BlockHeight {
+		t.Errorf("results[0] = %+v, want Proven at height %d", results[0], bump.BlockHeight)
+	}
+	if results[1].Proven {
+		t.Errorf("results[1] = %+v, want not Proven (no BUMP attached)", results[1])
+	}
+}
+
+func TestVerifyBEEFRejectsOversizedCounts(t *testing.T) {
+	// A 0xff prefix byte signals an 8-byte little-endian count follows;
+	// craft one claiming far more bumps than the blob could ever hold and
+	// make sure it's rejected rather than used to size an allocation.
+	blob := append(append([]byte{}, beefMagic[:]...), 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff)
+	if _, err := VerifyBEEF(blob, nil); !errors.Is(err, ErrInvalidBEEF) {
+		t.Fatalf("VerifyBEEF(oversized bump count) = %v, want ErrInvalidBEEF", err)
+	}
+}
+
+func TestDecodeBUMPRejectsOversizedNodeCount(t *testing.T) {
+	// height=0, one level, then a node count far larger than the bytes
+	// actually present.
+	data := []byte{0x00, 0x01, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff}
+	if _, err := DecodeBUMP(data); !errors.Is(err, ErrInvalidBUMP) {
+		t.Fatalf("DecodeBUMP(oversized node count) = %v, want ErrInvalidBUMP", err)
+	}
+}
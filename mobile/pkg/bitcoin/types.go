@@ -0,0 +1,85 @@
+package mobile
+
+import "errors"
+
+// Errors returned by the mobile SDK. They are exported so that gomobile
+// bindings can surface stable, comparable values to Java/Kotlin and
+// Swift/Objective-C callers instead of opaque error strings.
+var (
+	// ErrNotInitialized is returned when an SDK method is called before
+	// NewBitcoinMobileSDK has finished constructing the core backend.
+	ErrNotInitialized = errors.New("mobile: sdk not initialized")
+
+	// ErrInvalidTxHash is returned when a caller supplies a transaction
+	// hash that is not valid hex or is the wrong length.
+	ErrInvalidTxHash = errors.New("mobile: invalid transaction hash")
+
+	// ErrInvalidPath is returned when a caller supplies an empty BIP-32
+	// derivation path. A non-empty path is passed to the core unchecked.
+	ErrInvalidPath = errors.New("mobile: invalid derivation path")
+
+	// ErrInvalidAmount is returned when a requested amount is zero or
+	// negative.
+	ErrInvalidAmount = errors.New("mobile: invalid amount")
+
+	// ErrBackendUnavailable is returned when the Rust FFI core could not
+	// be reached.
+	ErrBackendUnavailable = errors.New("mobile: core backend unavailable")
+
+	// ErrPaymentNotCancelable is returned when CancelInvoice is called on
+	// a payment hash the core no longer considers cancelable (e.g. it
+	// already settled or failed).
+	ErrPaymentNotCancelable = errors.New("mobile: payment not cancelable")
+
+	// ErrInvalidAddress is returned when a caller supplies an empty
+	// Bitcoin address. A non-empty address is passed to the core
+	// unchecked.
+	ErrInvalidAddress = errors.New("mobile: invalid address")
+
+	// ErrInvalidInvoice is returned when a caller supplies an empty BOLT11
+	// invoice string. A non-empty invoice is passed to the core unchecked.
+	ErrInvalidInvoice = errors.New("mobile: invalid invoice")
+
+	// ErrInvalidPaymentHash is returned when a caller supplies an empty
+	// Lightning payment hash.
+	ErrInvalidPaymentHash = errors.New("mobile: invalid payment hash")
+)
+
+// Config carries the settings a mobile app supplies when constructing a
+// BitcoinMobileSDK. All fields have sane zero values so callers can pass an
+// empty Config for mainnet defaults.
+type Config struct {
+	// Network selects which NetworkParams the SDK operates under.
+	Network Network
+
+	// DataDir is where the SDK may persist watch state, wallet metadata,
+	// and other small on-device data. Mobile platforms should point this
+	// at their app-private storage directory.
+	DataDir string
+
+	// EVMRegistryAddress is the default account-abstraction registry
+	// contract BitcoinAddressToEthAddress and DeriveSmartAccount consult
+	// when resolving a Bitcoin address to its bridged EVM identity.
+	EVMRegistryAddress string
+
+	// EVMFactoryAddress is the default ERC-4337 kernel factory contract
+	// DeriveSmartAccount uses to compute a smart contract account's
+	// address.
+	EVMFactoryAddress string
+}
+
+// Account is a gomobile-safe view of a Bitcoin account: enough information
+// for a wallet UI to display and use the account without needing to touch
+// the underlying private key material directly.
+type Account struct {
+	// Address is the account's default receive address, encoded for
+	// Network.
+	Address string
+
+	// PublicKeyHex is the compressed secp256k1 public key, hex encoded.
+	PublicKeyHex string
+
+	// Path is the BIP-32 derivation path the account was derived from,
+	// e.g. "m/84'/0'/0'/0/0".
+	Path string
+}
@@ -0,0 +1,180 @@
+package mobile
+
+import (
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"sync"
+)
+
+// WatchKind distinguishes which rail a PaymentChecker watch is tracking.
+type WatchKind int
+
+const (
+	// WatchOnChain tracks a Bitcoin address or BIP21 URI via SPV proof.
+	WatchOnChain WatchKind = iota
+	// WatchLightning tracks a Lightning invoice by its payment hash.
+	WatchLightning
+)
+
+// WatchStatus is the lifecycle state of a registered watch.
+type WatchStatus int
+
+const (
+	// WatchPending means no payment has been observed yet.
+	WatchPending WatchStatus = iota
+	// WatchDetected means a payment was seen but not yet confirmed or
+	// fully paid.
+	WatchDetected
+	// WatchPartiallyPaid means some, but not all, of the expected amount
+	// has been seen.
+	WatchPartiallyPaid
+	// WatchConfirmed means the payment met its required confirmations
+	// (on-chain) or settled (Lightning).
+	WatchConfirmed
+	// WatchExpired means the watch's expiry passed before the payment
+	// confirmed.
+	WatchExpired
+)
+
+// ExpectedPaymentRequest describes a payment a merchant or wallet expects
+// to receive and wants PaymentChecker to watch for.
+type ExpectedPaymentRequest struct {
+	// Address is an on-chain address or BIP21 URI to watch. Leave empty
+	// for a Lightning-only watch.
+	Address string
+
+	// PaymentHash is a Lightning invoice's payment hash to watch. A
+	// non-empty PaymentHash makes this a Lightning watch, and Address is
+	// then not checked; leave it empty for an on-chain watch.
+	PaymentHash []byte
+
+	// AmountSat is the expected payment amount in satoshis.
+	AmountSat int64
+
+	// ExpiresUnix is when the watch should stop waiting and report
+	// WatchExpired. Zero means the watch never expires on its own.
+	ExpiresUnix int64
+
+	// RequiredConfirmations is how many confirmations an on-chain
+	// payment needs before it is reported WatchConfirmed. Zero defaults
+	// to one.
+	RequiredConfirmations int32
+}
+
+// AddressPayment reports what the chain has observed toward a watched
+// on-chain address or BIP21 URI, even before the payment fully confirms.
+// It only identifies the transaction and amount; PaymentChecker gets the
+// transaction's confirmation count from VerifySPVProof, the same SPV path
+// used to confirm any other transaction's inclusion.
+type AddressPayment struct {
+	// Found is false if the core hasn't observed any transaction paying
+	// the address yet.
+	Found bool
+
+	// TxHash is the transaction that paid the address, once Found.
+	TxHash string
+
+	// ReceivedSat is the total amount received so far towards the
+	// address, which may be less than the watch's expected amount for a
+	// partially paid invoice.
+	ReceivedSat int64
+}
+
+// watch is the persisted state PaymentChecker keeps for one registered
+// ExpectedPaymentRequest.
+type watch struct {
+	ID          string
+	Kind        WatchKind
+	Request     ExpectedPaymentRequest
+	Status      WatchStatus
+	ReceivedSat int64
+	TxHash      string
+}
+
+// watchStore persists watches so they survive app restarts. PaymentChecker
+// depends on the interface rather than fileWatchStore directly so tests
+// can substitute an in-memory implementation.
+type watchStore interface {
+	Save(w *watch) error
+	Delete(id string) error
+	All() ([]*watch, error)
+}
+
+// fileWatchStore is a watchStore backed by a single JSON file. A mobile
+// wallet tracks at most a handful of outstanding payments at once, so a
+// whole-file read/rewrite on every change is simple and fast enough; it
+// avoids pulling in an embedded database dependency for this package.
+type fileWatchStore struct {
+	mu   sync.Mutex
+	path string
+}
+
+func newFileWatchStore(dataDir string) *fileWatchStore {
+	return &fileWatchStore{path: filepath.Join(dataDir, "payment_watches.json")}
+}
+
+func (s *fileWatchStore) Save(w *watch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watches, err := s.load()
+	if err != nil {
+		return err
+	}
+	watches[w.ID] = w
+	return s.persist(watches)
+}
+
+func (s *fileWatchStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watches, err := s.load()
+	if err != nil {
+		return err
+	}
+	delete(watches, id)
+	return s.persist(watches)
+}
+
+func (s *fileWatchStore) All() ([]*watch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	watches, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+	all := make([]*watch, 0, len(watches))
+	for _, w := range watches {
+		all = append(all, w)
+	}
+	return all, nil
+}
+
+func (s *fileWatchStore) load() (map[string]*watch, error) {
+	data, err := os.ReadFile(s.path)
+	if os.IsNotExist(err) {
+		return map[string]*watch{}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	watches := map[string]*watch{}
+	if err := json.Unmarshal(data, &watches); err != nil {
+		return nil, err
+	}
+	return watches, nil
+}
+
+func (s *fileWatchStore) persist(watches map[string]*watch) error {
+	data, err := json.Marshal(watches)
+	if err != nil {
+		return err
+	}
+	if err := os.MkdirAll(filepath.Dir(s.path), 0o700); err != nil {
+		return err
+	}
+	return os.WriteFile(s.path, data, 0o600)
+}
@@ -0,0 +1,118 @@
+package mobile
+
+import (
+	"sync"
+	"testing"
+)
+
+// memWatchStore is an in-memory watchStore for tests that don't care about
+// persistence, substituting for fileWatchStore per the watchStore interface
+// doc comment.
+type memWatchStore struct {
+	mu      sync.Mutex
+	watches map[string]*watch
+}
+
+func newMemWatchStore() *memWatchStore {
+	return &memWatchStore{watches: map[string]*watch{}}
+}
+
+func (s *memWatchStore) Save(w *watch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.watches[w.ID] = w
+	return nil
+}
+
+func (s *memWatchStore) Delete(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.watches, id)
+	return nil
+}
+
+func (s *memWatchStore) All() ([]*watch, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	all := make([]*watch, 0, len(s.watches))
+	for _, w := range s.watches {
+		all = append(all, w)
+	}
+	return all, nil
+}
+
+func TestFileWatchStoreSaveAndAll(t *testing.T) {
+	store := newFileWatchStore(t.TempDir())
+
+	w1 := &watch{ID: "w1", Kind: WatchOnChain, Status: WatchPending}
+	w2 := &watch{ID: "w2", Kind: WatchLightning, Status: WatchDetected}
+	if err := store.Save(w1); err != nil {
+		t.Fatalf("Save(w1): %v", err)
+	}
+	if err := store.Save(w2); err != nil {
+		t.Fatalf("Save(w2): %v", err)
+	}
+
+	all, err := store.All()
+	if err != nil {
+		t.Fatalf("All(): %v", err)
+	}
+	if len(all) != 2 {
+		t.Fatalf("All() returned %d watches, want 2", len(all))
+	}
+}
+
+func TestFileWatchStoreDelete(t *testing.T) {
+	store := newFileWatchStore(t.TempDir())
+
+	w := &watch{ID: "w1", Kind: WatchOnChain, Status: WatchPending}
+	if err := store.Save(w); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+	if err := store.Delete("w1"); err != nil {
+		t.Fatalf("Delete: %v", err)
+	}
+
+	all, err := store.All()
+	if err != nil {
+		t.Fatalf("All(): %v", err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("All() returned %d watches after Delete, want 0", len(all))
+	}
+}
+
+func TestFileWatchStoreReloadsAcrossInstances(t *testing.T) {
+	dir := t.TempDir()
+
+	first := newFileWatchStore(dir)
+	w := &watch{ID: "w1", Kind: WatchOnChain, Status: WatchDetected, TxHash: "abc", ReceivedSat: 42}
+	if err := first.Save(w); err != nil {
+		t.Fatalf("Save: %v", err)
+	}
+
+	second := newFileWatchStore(dir)
+	all, err := second.All()
+	if err != nil {
+		t.Fatalf("All(): %v", err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("All() returned %d watches, want 1", len(all))
+	}
+	got := all[0]
+	if got.ID != w.ID || got.TxHash != w.TxHash || got.ReceivedSat != w.ReceivedSat || got.Status != w.Status {
+		t.Errorf("reloaded watch = %+v, want %+v", got, w)
+	}
+}
+
+func TestFileWatchStoreAllOnMissingFileIsEmpty(t *testing.T) {
+	store := newFileWatchStore(t.TempDir())
+
+	all, err := store.All()
+	if err != nil {
+		t.Fatalf("All(): %v", err)
+	}
+	if len(all) != 0 {
+		t.Fatalf("All() = %d watches on a fresh store, want 0", len(all))
+	}
+}